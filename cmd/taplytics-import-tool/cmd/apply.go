@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/importer"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply <plan-file>",
+	Short: "Apply a plan previously computed by dry-run or import --dry-run",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		imp, err := importer.New(importerOptions("", false))
+		if err != nil {
+			return err
+		}
+
+		result, err := imp.Apply(cmd.Context(), args[0])
+		if result == nil {
+			return err
+		}
+
+		fmt.Printf("Imported %d features into DevCycle project %q\n", result.FeaturesImported, result.DVCProject)
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+}
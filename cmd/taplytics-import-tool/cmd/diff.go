@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/importer"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <file>",
+	Short: "Show which features in an export file already exist in DevCycle",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		imp, err := importer.New(importerOptions(args[0], true))
+		if err != nil {
+			return err
+		}
+
+		exists, err := imp.Diff(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		for key, alreadyExists := range exists {
+			if alreadyExists {
+				fmt.Printf("  = %s (already exists)\n", key)
+			} else {
+				fmt.Printf("  + %s (create)\n", key)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
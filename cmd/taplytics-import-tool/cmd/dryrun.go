@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/importer"
+)
+
+var dryRunCmd = &cobra.Command{
+	Use:   "dry-run <file>",
+	Short: "Show what an import would do without changing DevCycle",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		imp, err := importer.New(importerOptions(args[0], true))
+		if err != nil {
+			return err
+		}
+
+		_, err = imp.Run(cmd.Context())
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dryRunCmd)
+}
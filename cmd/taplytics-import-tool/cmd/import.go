@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/importer"
+)
+
+var importDryRun bool
+
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a feature flag export file into DevCycle",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		imp, err := importer.New(importerOptions(args[0], importDryRun))
+		if err != nil {
+			return err
+		}
+
+		result, err := imp.Run(cmd.Context())
+		if result == nil {
+			return err
+		}
+
+		if result.Plan == nil {
+			fmt.Printf("Imported %d features into DevCycle project %q\n", result.FeaturesImported, result.DVCProject)
+		}
+		return err
+	},
+}
+
+func init() {
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "compute and print the plan instead of importing")
+	rootCmd.AddCommand(importCmd)
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/importer"
+)
+
+var listFeaturesCmd = &cobra.Command{
+	Use:   "list-features",
+	Short: "List the features that currently exist in the DevCycle project",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		imp, err := importer.New(importerOptions("", true))
+		if err != nil {
+			return err
+		}
+
+		keys, err := imp.ListFeatures(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			fmt.Println(key)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(listFeaturesCmd)
+}
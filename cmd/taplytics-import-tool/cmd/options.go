@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/importer"
+)
+
+// importerOptions builds importer.Options for the given import file from the
+// persistent flags bound in root.go, with env var fallback handled by
+// pkg/importer itself.
+func importerOptions(filePath string, dryRun bool) importer.Options {
+	return importer.Options{
+		FilePath:       filePath,
+		SourceKind:     viper.GetString("source"),
+		DVCProject:     viper.GetString("dvc-project"),
+		DryRun:         dryRun,
+		PlanFile:       planFile,
+		Concurrency:    viper.GetInt("concurrency"),
+		RateLimit:      viper.GetFloat64("rate-limit"),
+		APIToken:       viper.GetString("api-token"),
+		ClientID:       viper.GetString("client-id"),
+		ClientSecret:   viper.GetString("client-secret"),
+		LogFormat:      viper.GetString("log-format"),
+		Progress:       !viper.GetBool("no-progress"),
+		UpdateExisting: viper.GetBool("update-existing"),
+		Prune:          viper.GetBool("prune"),
+	}
+}
@@ -0,0 +1,71 @@
+// Package cmd implements the taplytics-import-tool CLI on top of
+// pkg/importer. Each subcommand translates flags/env vars into an
+// importer.Options and delegates the actual work to that package.
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	dvcProject     string
+	apiToken       string
+	clientID       string
+	clientSecret   string
+	concurrency    int
+	rateLimit      float64
+	planFile       string
+	logFormat      string
+	noProgress     bool
+	sourceKind     string
+	updateExisting bool
+	prune          bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "taplytics-import-tool",
+	Short: "Import feature flag exports from Taplytics and other vendors into DevCycle",
+	Long: `taplytics-import-tool reads a feature flag export (Taplytics, LaunchDarkly,
+Split, or Optimizely, selected with --source) and creates the equivalent
+features, variables, variations, and targeting rules in a DevCycle project.`,
+}
+
+// Execute runs the root command.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	cobra.OnInitialize(initConfig)
+
+	rootCmd.PersistentFlags().StringVar(&dvcProject, "dvc-project", "", "DevCycle project key (overrides the dvc_project field in the import file)")
+	rootCmd.PersistentFlags().StringVar(&apiToken, "api-token", "", "DevCycle API token (env DEVCYCLE_API_TOKEN)")
+	rootCmd.PersistentFlags().StringVar(&clientID, "client-id", "", "DevCycle OAuth client ID (env DEVCYCLE_CLIENT_ID)")
+	rootCmd.PersistentFlags().StringVar(&clientSecret, "client-secret", "", "DevCycle OAuth client secret (env DEVCYCLE_CLIENT_SECRET)")
+	rootCmd.PersistentFlags().IntVar(&concurrency, "concurrency", 4, "number of features to import concurrently")
+	rootCmd.PersistentFlags().Float64Var(&rateLimit, "rate-limit", 10, "maximum DevCycle API requests per second")
+	rootCmd.PersistentFlags().StringVar(&planFile, "plan-file", "", "path to persist the computed plan to (dry-run/import) or read it from (apply)")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "console", "log output format: console or json")
+	rootCmd.PersistentFlags().BoolVar(&noProgress, "no-progress", false, "disable the TTY progress bar while importing")
+	rootCmd.PersistentFlags().StringVar(&sourceKind, "source", "taplytics", "feature flag vendor to import from: taplytics, launchdarkly, split, or optimizely")
+	rootCmd.PersistentFlags().BoolVar(&updateExisting, "update-existing", false, "reconcile features that already exist in DevCycle instead of skipping them")
+	rootCmd.PersistentFlags().BoolVar(&prune, "prune", false, "with --update-existing, remove variables/variations not present in the source instead of merging additively")
+
+	_ = viper.BindPFlag("dvc-project", rootCmd.PersistentFlags().Lookup("dvc-project"))
+	_ = viper.BindPFlag("api-token", rootCmd.PersistentFlags().Lookup("api-token"))
+	_ = viper.BindPFlag("client-id", rootCmd.PersistentFlags().Lookup("client-id"))
+	_ = viper.BindPFlag("client-secret", rootCmd.PersistentFlags().Lookup("client-secret"))
+	_ = viper.BindPFlag("concurrency", rootCmd.PersistentFlags().Lookup("concurrency"))
+	_ = viper.BindPFlag("rate-limit", rootCmd.PersistentFlags().Lookup("rate-limit"))
+	_ = viper.BindPFlag("log-format", rootCmd.PersistentFlags().Lookup("log-format"))
+	_ = viper.BindPFlag("no-progress", rootCmd.PersistentFlags().Lookup("no-progress"))
+	_ = viper.BindPFlag("source", rootCmd.PersistentFlags().Lookup("source"))
+	_ = viper.BindPFlag("update-existing", rootCmd.PersistentFlags().Lookup("update-existing"))
+	_ = viper.BindPFlag("prune", rootCmd.PersistentFlags().Lookup("prune"))
+}
+
+func initConfig() {
+	viper.SetEnvPrefix("devcycle")
+	viper.AutomaticEnv()
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/importer"
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate <file>",
+	Short: "Parse and validate a source export file without contacting DevCycle",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		count, err := importer.ValidateFile(cmd.Context(), viper.GetString("source"), args[0])
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s is valid: %d features would be imported\n", args[0], count)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+}
@@ -0,0 +1,17 @@
+// Command taplytics-import-tool imports Taplytics feature flag exports into
+// DevCycle.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/cmd/taplytics-import-tool/cmd"
+)
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
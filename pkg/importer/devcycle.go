@@ -0,0 +1,823 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/source"
+)
+
+var sharedHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// defaultRateLimit is sized well within DevCycle's documented per-token API
+// limits so a default-concurrency import never gets throttled in practice.
+const defaultRateLimit = 10.0
+
+type DevCycleVariable struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Key         string `json:"key"`
+	Type        string `json:"type,omitempty"`
+}
+
+type DevCycleVariation struct {
+	Key       string         `json:"key"`
+	Name      string         `json:"name"`
+	Variables map[string]any `json:"variables"`
+}
+
+type SdkVisibility struct {
+	Mobile bool `json:"mobile"`
+	Client bool `json:"client"`
+	Server bool `json:"server"`
+}
+
+type DevCycleNewFeaturePOSTBody struct {
+	Name          string              `json:"name"`
+	Key           string              `json:"key"`
+	Description   string              `json:"description"`
+	Variables     []DevCycleVariable  `json:"variables"`
+	Variations    []DevCycleVariation `json:"variations"`
+	SdkVisibility SdkVisibility       `json:"sdkVisibility"`
+	Type          string              `json:"type"`
+	Tags          []string            `json:"tags"`
+}
+
+// --- DevCycle API helpers ---
+
+type devcycleAPI struct {
+	baseURL        string
+	token          string
+	client         *http.Client
+	limiter        *rate.Limiter
+	concurrency    int
+	logger         *slog.Logger
+	updateExisting bool
+	prune          bool
+	sourceKind     string
+}
+
+// sourceDisplayName returns the human-readable vendor name for a
+// source.New kind, for use in generated feature/variable descriptions.
+func sourceDisplayName(kind string) string {
+	switch kind {
+	case "launchdarkly":
+		return "LaunchDarkly"
+	case "split":
+		return "Split"
+	case "optimizely":
+		return "Optimizely"
+	default:
+		return "Taplytics"
+	}
+}
+
+// GetDevCycleOAuthToken requests an OAuth token from DevCycle using client credentials.
+func GetDevCycleOAuthToken(clientID, clientSecret string) (string, error) {
+	url := "https://auth.devcycle.com/oauth/token"
+
+	payload := map[string]string{
+		"grant_type":    "client_credentials",
+		"client_id":     clientID,
+		"client_secret": clientSecret,
+		"audience":      "https://api.devcycle.com/",
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("unexpected status: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.AccessToken, nil
+}
+
+// newDevCycleAPI builds a devcycleAPI client, resolving credentials from the
+// given Options and falling back to the DEVCYCLE_API_TOKEN /
+// DEVCYCLE_CLIENT_ID / DEVCYCLE_CLIENT_SECRET environment variables.
+func newDevCycleAPI(opts Options) (*devcycleAPI, error) {
+	token := opts.APIToken
+	if token == "" {
+		token = os.Getenv("DEVCYCLE_API_TOKEN")
+	}
+
+	clientID := opts.ClientID
+	if clientID == "" {
+		clientID = os.Getenv("DEVCYCLE_CLIENT_ID")
+	}
+	clientSecret := opts.ClientSecret
+	if clientSecret == "" {
+		clientSecret = os.Getenv("DEVCYCLE_CLIENT_SECRET")
+	}
+
+	if token == "" {
+		if clientID == "" || clientSecret == "" {
+			return nil, fmt.Errorf("no DevCycle credentials provided: set an API token or a client ID/secret pair")
+		}
+		var err error
+		token, err = GetDevCycleOAuthToken(clientID, clientSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain DevCycle OAuth token: %w", err)
+		}
+	}
+
+	rateLimit := opts.RateLimit
+	if rateLimit <= 0 {
+		rateLimit = defaultRateLimit
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	return &devcycleAPI{
+		baseURL:        "https://api.devcycle.com/v1",
+		token:          token,
+		client:         sharedHTTPClient,
+		limiter:        rate.NewLimiter(rate.Limit(rateLimit), concurrency),
+		concurrency:    concurrency,
+		logger:         newLogger(opts.LogFormat),
+		updateExisting: opts.UpdateExisting,
+		prune:          opts.Prune,
+		sourceKind:     opts.SourceKind,
+	}, nil
+}
+
+func (api *devcycleAPI) doRequest(ctx context.Context, method, url string, body any) (*http.Response, error) {
+	ctx, span := tracer.Start(ctx, spanName("doRequest"))
+	defer span.End()
+	span.SetAttributes(attribute.String("http.method", method), attribute.String("http.url", url))
+
+	if err := api.limiter.Wait(ctx); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var buf io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		buf = bytes.NewBuffer(jsonData)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, buf)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+api.token)
+
+	start := time.Now()
+	resp, err := api.client.Do(req)
+	apiLatency.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("http.method", method),
+	))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+	return resp, nil
+}
+
+// doRequestWithRetry behaves like doRequest but retries 429s and 5xx
+// responses with exponential backoff, honoring a Retry-After header when
+// present. Non-retryable statuses and network errors that exhaust the
+// retry budget are returned as-is.
+func (api *devcycleAPI) doRequestWithRetry(ctx context.Context, method, url string, body any) (*http.Response, error) {
+	var resp *http.Response
+
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = 30 * time.Second
+
+	attempt := 0
+	operation := func() error {
+		if attempt > 0 {
+			retryCount.Add(ctx, 1, metric.WithAttributes(attribute.String("http.method", method)))
+			api.logger.Warn("retrying DevCycle API request", "method", method, "url", url, "attempt", attempt)
+		}
+		attempt++
+
+		r, err := api.doRequest(ctx, method, url, body)
+		if err != nil {
+			return err
+		}
+
+		if r.StatusCode == http.StatusTooManyRequests || r.StatusCode >= http.StatusInternalServerError {
+			respBody, _ := io.ReadAll(r.Body)
+			r.Body.Close()
+			if wait := parseRetryAfter(r.Header.Get("Retry-After")); wait > 0 {
+				time.Sleep(wait)
+			}
+			return fmt.Errorf("API returned retryable error %d: %s", r.StatusCode, string(respBody))
+		}
+
+		resp = r
+		return nil
+	}
+
+	if err := backoff.Retry(operation, backoff.WithContext(b, ctx)); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// parseRetryAfter parses an HTTP Retry-After header given as a number of
+// seconds. A malformed or absent header yields zero, letting the caller
+// fall back to its own backoff interval.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (api *devcycleAPI) getExistingCustomProperties(ctx context.Context, dvcProject string) ([]string, error) {
+	url := fmt.Sprintf("%s/projects/%s/customProperties", api.baseURL, dvcProject)
+	resp, err := api.doRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, string(body))
+	}
+	var response []struct {
+		Key         string `json:"key"`
+		PropertyKey string `json:"propertyKey"`
+		Name        string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, prop := range response {
+		result = append(result, prop.PropertyKey)
+	}
+	return result, nil
+}
+
+func (api *devcycleAPI) getExistingFeatures(ctx context.Context, dvcProject string) ([]string, error) {
+	url := fmt.Sprintf("%s/projects/%s/features", api.baseURL, dvcProject)
+	resp, err := api.doRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, string(body))
+	}
+	var response []struct {
+		Key string `json:"key"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, feature := range response {
+		result = append(result, feature.Key)
+	}
+	return result, nil
+}
+
+// getDevCycleFeature fetches a single feature's current configuration, used
+// to reconcile it against the source when UpdateExisting is set.
+func (api *devcycleAPI) getDevCycleFeature(ctx context.Context, dvcProject, featureKey string) (*DevCycleNewFeaturePOSTBody, error) {
+	url := fmt.Sprintf("%s/projects/%s/features/%s", api.baseURL, dvcProject, featureKey)
+	resp, err := api.doRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API returned error %d: %s", resp.StatusCode, string(body))
+	}
+	var feature DevCycleNewFeaturePOSTBody
+	if err := json.NewDecoder(resp.Body).Decode(&feature); err != nil {
+		return nil, err
+	}
+	return &feature, nil
+}
+
+// reconcileExistingFeature fetches the current state of a feature that
+// already exists in DevCycle and PATCHes it to converge with desired: tags
+// and sdkVisibility are always taken from desired, while variables and
+// variations are merged with the existing ones additively, or replaced
+// outright when api.prune is set.
+func (api *devcycleAPI) reconcileExistingFeature(ctx context.Context, dvcProject, featureKey string, desired DevCycleNewFeaturePOSTBody) error {
+	existing, err := api.getDevCycleFeature(ctx, dvcProject, featureKey)
+	if err != nil {
+		return fmt.Errorf("failed to fetch existing feature: %w", err)
+	}
+
+	payload := map[string]interface{}{
+		"variables":     mergeVariables(existing.Variables, desired.Variables, api.prune),
+		"variations":    mergeVariations(existing.Variations, desired.Variations, api.prune),
+		"tags":          desired.Tags,
+		"sdkVisibility": desired.SdkVisibility,
+	}
+
+	resp, err := api.doRequestWithRetry(ctx, "PATCH", fmt.Sprintf("%s/projects/%s/features/%s", api.baseURL, dvcProject, featureKey), payload)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API returned error %d: %s", resp.StatusCode, string(body))
+	}
+	api.logger.Info("reconciled existing feature", "feature", featureKey, "prune", api.prune)
+	return nil
+}
+
+// mergeVariables combines existing and desired variables by key. With
+// prune, only desired's variables survive (an authoritative sync); without
+// it, existing variables absent from desired are kept alongside desired's
+// (an additive sync).
+func mergeVariables(existing, desired []DevCycleVariable, prune bool) []DevCycleVariable {
+	byKey := make(map[string]DevCycleVariable, len(existing)+len(desired))
+	if !prune {
+		for _, v := range existing {
+			byKey[v.Key] = v
+		}
+	}
+	for _, v := range desired {
+		byKey[v.Key] = v
+	}
+	merged := make([]DevCycleVariable, 0, len(byKey))
+	for _, v := range byKey {
+		merged = append(merged, v)
+	}
+	return merged
+}
+
+// mergeVariations behaves like mergeVariables, operating on variations.
+func mergeVariations(existing, desired []DevCycleVariation, prune bool) []DevCycleVariation {
+	byKey := make(map[string]DevCycleVariation, len(existing)+len(desired))
+	if !prune {
+		for _, v := range existing {
+			byKey[v.Key] = v
+		}
+	}
+	for _, v := range desired {
+		byKey[v.Key] = v
+	}
+	merged := make([]DevCycleVariation, 0, len(byKey))
+	for _, v := range byKey {
+		merged = append(merged, v)
+	}
+	return merged
+}
+
+func (api *devcycleAPI) createCustomProperty(ctx context.Context, dvcProject, key, dataType string) error {
+	dvcType := "String"
+	switch dataType {
+	case "Boolean":
+		dvcType = "Boolean"
+	case "Number":
+		dvcType = "Number"
+	case "JSON":
+		dvcType = "JSON"
+	}
+	payload := map[string]interface{}{
+		"name":        key,
+		"propertyKey": key,
+		"key":         strings.ToLower(key),
+		"type":        dvcType,
+	}
+	url := fmt.Sprintf("%s/projects/%s/customProperties", api.baseURL, dvcProject)
+	resp, err := api.doRequest(ctx, "POST", url, payload)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API returned error %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// --- Feature creation ---
+
+func (api *devcycleAPI) createDevCycleFeature(ctx context.Context, dvcProject string, tlFeature source.CanonicalFeature) error {
+	ctx, span := tracer.Start(ctx, spanName("createDevCycleFeature"))
+	defer span.End()
+	span.SetAttributes(attribute.String("dvc.feature_name", tlFeature.Name))
+
+	featureKey := GenerateFeatureKey(tlFeature.Name)
+
+	var variables []DevCycleVariable
+	var dedupeVariables = make(map[string]string)
+	var variations []DevCycleVariation
+
+	variationDistributionPct := make(map[string]float64, len(tlFeature.Variations))
+
+	for _, tlVariation := range tlFeature.Variations {
+		variationValues := make(map[string]any, len(tlVariation.Variables))
+		for _, tlVariable := range tlVariation.Variables {
+			variationValues[GenerateFeatureKey(tlVariable.Name)] = tlVariable.Value
+			if _, exists := dedupeVariables[tlVariable.Name]; !exists {
+				dedupeVariables[tlVariable.Name] = tlVariable.Type
+				variables = append(variables, DevCycleVariable{
+					Name:        tlVariable.Name,
+					Key:         GenerateFeatureKey(tlVariable.Name),
+					Type:        tlVariable.Type,
+					Description: fmt.Sprintf("Imported from %s: %s", sourceDisplayName(api.sourceKind), tlVariable.Name),
+				})
+			}
+		}
+		variations = append(variations, DevCycleVariation{
+			Key:       GenerateFeatureKey(tlVariation.Name),
+			Name:      tlVariation.Name,
+			Variables: variationValues,
+		})
+		variationDistributionPct[GenerateFeatureKey(tlVariation.Name)] = tlVariation.Distribution
+	}
+
+	if len(variables) == 0 {
+		api.logger.Info("no variables to import for feature", "feature", tlFeature.Name)
+		return nil
+	}
+
+	featurePayload := DevCycleNewFeaturePOSTBody{
+		Name:          tlFeature.Name,
+		Key:           featureKey,
+		Description:   fmt.Sprintf("Imported from %s: %s", sourceDisplayName(api.sourceKind), tlFeature.Name),
+		Variables:     variables,
+		Variations:    variations,
+		SdkVisibility: SdkVisibility{Mobile: true, Client: true, Server: true},
+		Type:          "release",
+		Tags:          tlFeature.Tags,
+	}
+
+	resp, err := api.doRequestWithRetry(ctx, "POST", fmt.Sprintf("%s/projects/%s/features", api.baseURL, dvcProject), featurePayload)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to create feature %s: %w", tlFeature.Name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		if !api.updateExisting {
+			api.logger.Info("feature already exists, skipping creation", "feature", tlFeature.Name)
+			return nil
+		}
+		if err := api.reconcileExistingFeature(ctx, dvcProject, featureKey, featurePayload); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf("failed to reconcile existing feature %s: %w", tlFeature.Name, err)
+		}
+		return api.reconcileTargetingRules(ctx, dvcProject, featureKey, tlFeature)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		err := fmt.Errorf("API returned error %d: %s", resp.StatusCode, string(body))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	var createResponse struct {
+		ID         string `json:"_id"`
+		Variations []struct {
+			ID  string `json:"_id"`
+			Key string `json:"key"`
+		} `json:"variations"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&createResponse); err != nil {
+		return fmt.Errorf("failed to parse feature creation response: %w", err)
+	}
+
+	variationIdMap := make(map[string]string)
+	for _, variation := range createResponse.Variations {
+		variationIdMap[variation.Key] = variation.ID
+	}
+
+	if err := api.reconcileTargetingRules(ctx, dvcProject, featureKey, tlFeature); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
+
+// reconcileTargetingRules (re)applies the feature's targeting rules to
+// every environment. createTargetingRule's PATCH already replaces an
+// environment's targets wholesale, so calling it again on an existing
+// feature converges its targeting with the source without any separate
+// diffing.
+func (api *devcycleAPI) reconcileTargetingRules(ctx context.Context, dvcProject, featureKey string, tlFeature source.CanonicalFeature) error {
+	if len(tlFeature.Targets) == 0 {
+		return nil
+	}
+	for _, env := range []string{"development", "staging", "production"} {
+		if err := api.createTargetingRule(ctx, dvcProject, featureKey, env, tlFeature); err != nil {
+			return fmt.Errorf("failed to create targeting rules: %w", err)
+		}
+	}
+	return nil
+}
+
+// --- Feature configuration (targeting rule) ---
+
+func (api *devcycleAPI) createTargetingRule(ctx context.Context, dvcProject, featureKey, environmentKey string, tlFeature source.CanonicalFeature) error {
+	ctx, span := tracer.Start(ctx, spanName("createTargetingRule"))
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("dvc.feature_key", featureKey),
+		attribute.String("dvc.environment", environmentKey),
+	)
+
+	targets := make([]interface{}, 0, len(tlFeature.Targets))
+	for _, target := range tlFeature.Targets {
+		distribRecord := make([]map[string]interface{}, 0, len(target.Distribution))
+		for _, dist := range target.Distribution {
+			distribRecord = append(distribRecord, canonicalDistributionToAPI(dist))
+		}
+		targets = append(targets, map[string]interface{}{
+			"audience":     canonicalAudienceToAPI(target.Audience),
+			"distribution": distribRecord,
+		})
+	}
+
+	configPayload := map[string]interface{}{
+		"targets": targets,
+		"status":  "active",
+	}
+	url := fmt.Sprintf("%s/projects/%s/features/%s/configurations?environment=%s", api.baseURL, dvcProject, featureKey, environmentKey)
+	resp, err := api.doRequestWithRetry(ctx, "PATCH", url, configPayload)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		err := fmt.Errorf("API returned error %d: %s", resp.StatusCode, string(body))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
+
+// canonicalDistributionToAPI builds the DevCycle configuration-API payload
+// for a single variation's rollout percentage.
+func canonicalDistributionToAPI(d source.CanonicalDistribution) map[string]interface{} {
+	return map[string]interface{}{
+		"_variation": GenerateFeatureKey(d.VariationName),
+		"percentage": d.Percentage,
+	}
+}
+
+// filterSubTypeTranslation renames a source filter's subType to DevCycle's
+// vocabulary. SubTypes not listed here (including any future/unrecognized
+// ones) pass through unchanged rather than being dropped, so an unknown
+// subType still reaches DevCycle instead of silently vanishing.
+var filterSubTypeTranslation = map[string]string{
+	"user_id": "userId",
+}
+
+// filterComparatorTranslation renames a source filter's comparator to
+// DevCycle's vocabulary, including the negated forms of each comparator
+// (!=, !contain, !exist). Comparators not listed here pass through
+// unchanged.
+var filterComparatorTranslation = map[string]string{
+	"eq":          "=",
+	"neq":         "!=",
+	"contain":     "contain",
+	"not_contain": "!contain",
+	"exist":       "exist",
+	"not_exist":   "!exist",
+	"gt":          ">",
+	"gte":         ">=",
+	"lt":          "<",
+	"lte":         "<=",
+	"before":      "<",
+	"after":       ">",
+}
+
+// canonicalAudienceToAPI builds the DevCycle configuration-API payload for a
+// single target's audience.
+func canonicalAudienceToAPI(a source.CanonicalAudience) map[string]interface{} {
+	filters := make([]map[string]interface{}, 0, len(a.Filters.Filters))
+	for _, filter := range a.Filters.Filters {
+		filters = append(filters, canonicalFilterToAPI(filter))
+	}
+	return map[string]interface{}{
+		"name": a.Name,
+		"filters": map[string]interface{}{
+			"operator": a.Filters.Operator,
+			"filters":  filters,
+		},
+	}
+}
+
+// canonicalFilterToAPI translates a single canonical filter into DevCycle's
+// targeting filter shape, renaming subTypes and comparators (including
+// negations) via filterSubTypeTranslation and filterComparatorTranslation.
+// platform/app version values are normalized to a full major.minor.patch
+// form so DevCycle's semver comparison doesn't choke on a bare major.minor.
+func canonicalFilterToAPI(filter source.CanonicalFilter) map[string]interface{} {
+	subType := filter.SubType
+	if renamed, ok := filterSubTypeTranslation[subType]; ok {
+		subType = renamed
+	}
+	comparator := filter.Comparator
+	if renamed, ok := filterComparatorTranslation[comparator]; ok {
+		comparator = renamed
+	}
+
+	values := filter.Values
+	switch subType {
+	case "appVersion", "platformVersion":
+		values = make([]any, len(filter.Values))
+		for i, v := range filter.Values {
+			str, ok := v.(string)
+			if !ok {
+				values[i] = v
+				continue
+			}
+			if len(strings.Split(str, ".")) == 2 {
+				str += ".0" // Ensure it has a patch version
+			}
+			values[i] = str
+		}
+	}
+
+	dvcFilter := map[string]interface{}{
+		"type":       "user",
+		"subType":    subType,
+		"comparator": comparator,
+		"values":     values,
+	}
+	if filter.SubType == "customData" {
+		dvcFilter["dataKey"] = filter.DataKey
+		dvcFilter["dataKeyType"] = filter.DataKeyType
+	}
+	return dvcFilter
+}
+
+// importFeaturesToDevCycle imports mergedFeatures into dvcProject using a
+// bounded worker pool. When dryRun is true, it instead fetches the
+// project's existing features and custom properties and returns a Plan
+// describing what would happen, without issuing any POST/PATCH request.
+//
+// Feature imports are independent, so a failure on one feature does not
+// stop the others: every error is collected and returned together via
+// errors.Join alongside a count of the features that did succeed.
+func (api *devcycleAPI) importFeaturesToDevCycle(ctx context.Context, dvcProject string, mergedFeatures map[string]source.CanonicalFeature, dryRun bool, progress progressReporter) (*Plan, int, error) {
+	// First, work out which custom data properties are required
+	customDataProps := make(map[string]string)
+	for _, feature := range mergedFeatures {
+		for _, target := range feature.Targets {
+			for _, filter := range target.Audience.Filters.Filters {
+				if filter.SubType == "customData" {
+					customDataProps[filter.DataKey] = filter.DataKeyType
+				}
+			}
+		}
+	}
+
+	if dryRun {
+		existingProps, err := api.getExistingCustomProperties(ctx, dvcProject)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get existing custom properties: %w", err)
+		}
+		existingFeatures, err := api.getExistingFeatures(ctx, dvcProject)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get existing features: %w", err)
+		}
+		return buildPlan(dvcProject, existingFeatures, existingProps, customDataProps, mergedFeatures, api.updateExisting, api.prune), 0, nil
+	}
+
+	if err := api.checkAndCreateCustomProperties(ctx, dvcProject, customDataProps); err != nil {
+		return nil, 0, fmt.Errorf("failed to set up custom properties: %w", err)
+	}
+
+	jobs := make(chan source.CanonicalFeature)
+	go func() {
+		defer close(jobs)
+		for _, feature := range mergedFeatures {
+			jobs <- feature
+		}
+	}()
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		errs      []error
+		succeeded int
+	)
+
+	for i := 0; i < api.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for feature := range jobs {
+				err := api.createDevCycleFeature(ctx, dvcProject, feature)
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to import feature %s: %w", feature.Name, err))
+					featuresProcessed.Add(ctx, 1, metric.WithAttributes(attribute.String("outcome", "error")))
+				} else {
+					succeeded++
+					featuresProcessed.Add(ctx, 1, metric.WithAttributes(attribute.String("outcome", "success")))
+					api.logger.Info("imported feature", "feature", feature.Name)
+				}
+				progress.Add(1)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	progress.Close()
+
+	return nil, succeeded, errors.Join(errs...)
+}
+
+func (api *devcycleAPI) checkAndCreateCustomProperties(ctx context.Context, dvcProject string, customData map[string]string) error {
+	ctx, span := tracer.Start(ctx, spanName("checkAndCreateCustomProperties"))
+	defer span.End()
+
+	existingProps, err := api.getExistingCustomProperties(ctx, dvcProject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to get existing custom properties: %w", err)
+	}
+	for _, prop := range existingProps {
+		if _, exists := customData[prop]; exists {
+			api.logger.Info("found existing custom property, skipping", "property", prop)
+			delete(customData, prop) // Remove from customData if it already exists
+		}
+	}
+	for key, dataType := range customData {
+		if key == "" {
+			continue
+		}
+		if err := api.createCustomProperty(ctx, dvcProject, key, dataType); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf("failed to create custom property %s: %w", key, err)
+		}
+		api.logger.Info("created custom property", "property", key, "type", dataType)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package importer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/source"
+)
+
+func Test_canonicalFilterToAPI(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filter   source.CanonicalFilter
+		expected map[string]interface{}
+	}{
+		{
+			name:   "platform equality",
+			filter: source.CanonicalFilter{SubType: "platform", Comparator: "eq", Values: []any{"ios"}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "platform", "comparator": "=", "values": []any{"ios"},
+			},
+		},
+		{
+			name:   "country negated contains",
+			filter: source.CanonicalFilter{SubType: "country", Comparator: "not_contain", Values: []any{"US", "CA"}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "country", "comparator": "!contain", "values": []any{"US", "CA"},
+			},
+		},
+		{
+			name:   "language exists",
+			filter: source.CanonicalFilter{SubType: "language", Comparator: "exist", Values: []any{}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "language", "comparator": "exist", "values": []any{},
+			},
+		},
+		{
+			name:   "email not equal",
+			filter: source.CanonicalFilter{SubType: "email", Comparator: "neq", Values: []any{"test@example.com"}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "email", "comparator": "!=", "values": []any{"test@example.com"},
+			},
+		},
+		{
+			name:   "user_id renamed to userId",
+			filter: source.CanonicalFilter{SubType: "user_id", Comparator: "eq", Values: []any{"u123"}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "userId", "comparator": "=", "values": []any{"u123"},
+			},
+		},
+		{
+			name:   "user_id does not exist",
+			filter: source.CanonicalFilter{SubType: "user_id", Comparator: "not_exist", Values: []any{}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "userId", "comparator": "!exist", "values": []any{},
+			},
+		},
+		{
+			name:   "numeric custom data",
+			filter: source.CanonicalFilter{SubType: "customData", Comparator: "gte", Values: []any{18.0}, DataKey: "age", DataKeyType: "Number"},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "customData", "comparator": ">=", "values": []any{18.0},
+				"dataKey": "age", "dataKeyType": "Number",
+			},
+		},
+		{
+			name:   "date custom data before",
+			filter: source.CanonicalFilter{SubType: "customData", Comparator: "before", Values: []any{"2024-01-01"}, DataKey: "signupDate", DataKeyType: "Date"},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "customData", "comparator": "<", "values": []any{"2024-01-01"},
+				"dataKey": "signupDate", "dataKeyType": "Date",
+			},
+		},
+		{
+			name:   "app version gets a patch version appended",
+			filter: source.CanonicalFilter{SubType: "appVersion", Comparator: "gte", Values: []any{"1.2"}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "appVersion", "comparator": ">=", "values": []any{"1.2.0"},
+			},
+		},
+		{
+			name:   "platform version with a full version is left alone",
+			filter: source.CanonicalFilter{SubType: "platformVersion", Comparator: "lt", Values: []any{"14.4.1"}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "platformVersion", "comparator": "<", "values": []any{"14.4.1"},
+			},
+		},
+		{
+			name:   "unrecognized subType and comparator pass through unchanged",
+			filter: source.CanonicalFilter{SubType: "deviceType", Comparator: "matches", Values: []any{"tablet"}},
+			expected: map[string]interface{}{
+				"type": "user", "subType": "deviceType", "comparator": "matches", "values": []any{"tablet"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := canonicalFilterToAPI(testCase.filter)
+			if !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("expected %#v, got %#v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func Test_canonicalAudienceToAPI(t *testing.T) {
+	audience := source.CanonicalAudience{
+		Name: "Targeted Users",
+		Filters: source.CanonicalFilterGroup{
+			Operator: "and",
+			Filters: []source.CanonicalFilter{
+				{SubType: "platform", Comparator: "eq", Values: []any{"ios"}},
+				{SubType: "user_id", Comparator: "not_exist", Values: []any{}},
+			},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"name": "Targeted Users",
+		"filters": map[string]interface{}{
+			"operator": "and",
+			"filters": []map[string]interface{}{
+				{"type": "user", "subType": "platform", "comparator": "=", "values": []any{"ios"}},
+				{"type": "user", "subType": "userId", "comparator": "!exist", "values": []any{}},
+			},
+		},
+	}
+
+	result := canonicalAudienceToAPI(audience)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %#v, got %#v", expected, result)
+	}
+}
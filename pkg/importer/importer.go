@@ -0,0 +1,262 @@
+// Package importer contains the reusable DevCycle import logic: loading a
+// vendor export via pkg/source, merging it into DevCycle features, and
+// talking to the DevCycle API. It has no CLI dependencies so it can be
+// embedded by other Go programs (e.g. migration pipelines) in addition to
+// the cmd/taplytics-import-tool CLI.
+package importer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/source"
+)
+
+// Options configures a single import run.
+type Options struct {
+	// FilePath is the path to the vendor export file (ignored by sources,
+	// like Optimizely, that load entirely from a remote API).
+	FilePath string
+
+	// SourceKind selects which vendor FilePath is parsed as: "taplytics"
+	// (the default), "launchdarkly", "split", or "optimizely".
+	SourceKind string
+
+	// DVCProject optionally overrides the dvc_project field in the export
+	// file.
+	DVCProject string
+
+	// DryRun, when true, computes what would change without issuing any
+	// mutating calls to the DevCycle API.
+	DryRun bool
+
+	// PlanFile, when set together with DryRun, persists the computed Plan
+	// as JSON so it can later be consumed by Importer.Apply.
+	PlanFile string
+
+	// UpdateExisting, when true, reconciles features that already exist in
+	// DevCycle (rather than skipping them on conflict) by fetching their
+	// current state and PATCHing the variables, variations, tags,
+	// sdkVisibility, and targeting rules that differ from the source.
+	UpdateExisting bool
+
+	// Prune, when true and combined with UpdateExisting, additionally
+	// removes variables and variations that exist in DevCycle but are no
+	// longer present in the source. Without it, reconciliation is
+	// additive: existing resources not present in the source are left
+	// alone.
+	Prune bool
+
+	// Concurrency controls how many features are imported in parallel.
+	Concurrency int
+
+	// RateLimit caps outgoing DevCycle API requests per second. Zero uses
+	// a built-in default sized to DevCycle's documented API limits.
+	RateLimit float64
+
+	// LogFormat selects "json" for structured log output or anything
+	// else (including the empty string) for human-readable console
+	// output.
+	LogFormat string
+
+	// Progress, when true, renders a TTY progress bar tracking features
+	// processed during Run.
+	Progress bool
+
+	// APIToken, ClientID, and ClientSecret configure DevCycle
+	// authentication. APIToken takes precedence; if unset, ClientID and
+	// ClientSecret are used to request an OAuth token. Any of these left
+	// empty fall back to the DEVCYCLE_API_TOKEN, DEVCYCLE_CLIENT_ID, and
+	// DEVCYCLE_CLIENT_SECRET environment variables.
+	APIToken     string
+	ClientID     string
+	ClientSecret string
+}
+
+// Result summarizes the outcome of an import run.
+type Result struct {
+	// DVCProject is the DevCycle project the features were imported into.
+	DVCProject string
+	// FeaturesImported is the number of features successfully created.
+	// Zero for a dry run.
+	FeaturesImported int
+	// Plan is populated instead of FeaturesImported when Options.DryRun is
+	// set.
+	Plan *Plan
+}
+
+// Importer drives a vendor-to-DevCycle import.
+type Importer struct {
+	opts Options
+	api  *devcycleAPI
+	src  source.Source
+}
+
+// New constructs an Importer, resolving DevCycle credentials from opts and
+// the environment, and selecting the source adapter named by
+// Options.SourceKind.
+func New(opts Options) (*Importer, error) {
+	api, err := newDevCycleAPI(opts)
+	if err != nil {
+		return nil, err
+	}
+	src, err := source.New(opts.SourceKind, opts.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	return &Importer{opts: opts, api: api, src: src}, nil
+}
+
+// Run loads the configured source export, merges its features into
+// DevCycle features, and imports them. If Options.DryRun is set, no
+// mutating API calls are made; instead the computed Plan is returned (and,
+// if Options.PlanFile is set, persisted to disk) for later use by Apply.
+func (imp *Importer) Run(ctx context.Context) (*Result, error) {
+	canonicalImport, dvcProject, err := imp.loadAndValidate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedFeatures := mergeRecords(canonicalImport.Features)
+
+	imp.api.logger.Info("starting import",
+		"features", len(mergedFeatures),
+		"dvc_project", dvcProject,
+		"custom_data_properties", canonicalImport.CustomDataProperties(),
+	)
+
+	progress := newProgressReporter(imp.opts.Progress && !imp.opts.DryRun, len(mergedFeatures))
+
+	plan, succeeded, err := imp.api.importFeaturesToDevCycle(ctx, dvcProject, mergedFeatures, imp.opts.DryRun, progress)
+	if imp.opts.DryRun {
+		if err != nil {
+			return nil, fmt.Errorf("error computing plan: %w", err)
+		}
+		plan.FilePath = imp.opts.FilePath
+		plan.SourceKind = imp.opts.SourceKind
+		fmt.Println(plan)
+		if imp.opts.PlanFile != "" {
+			if err := plan.WriteFile(imp.opts.PlanFile); err != nil {
+				return nil, err
+			}
+		}
+		return &Result{DVCProject: dvcProject, Plan: plan}, nil
+	}
+
+	// Feature imports are independent, so report how many succeeded even
+	// if some failed rather than discarding that information on error.
+	return &Result{DVCProject: dvcProject, FeaturesImported: succeeded}, err
+}
+
+// Apply re-runs the import described by a previously persisted Plan,
+// actually creating the planned resources in DevCycle.
+func (imp *Importer) Apply(ctx context.Context, planFile string) (*Result, error) {
+	plan, err := ReadPlanFile(planFile)
+	if err != nil {
+		return nil, err
+	}
+	imp.opts.FilePath = plan.FilePath
+	imp.opts.SourceKind = plan.SourceKind
+	imp.opts.DVCProject = plan.DVCProject
+	imp.opts.DryRun = false
+
+	src, err := source.New(imp.opts.SourceKind, imp.opts.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	imp.src = src
+	imp.api.sourceKind = plan.SourceKind
+
+	return imp.Run(ctx)
+}
+
+// ValidateFile parses and sanity-checks a vendor export file, returning the
+// number of features it would import. Unlike Importer.Run, it requires no
+// DevCycle credentials.
+func ValidateFile(ctx context.Context, sourceKind, filePath string) (int, error) {
+	src, err := source.New(sourceKind, filePath)
+	if err != nil {
+		return 0, err
+	}
+	canonicalImport, err := src.Load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(mergeRecords(canonicalImport.Features)), nil
+}
+
+// ListFeatures returns the keys of features that already exist in the
+// configured DevCycle project.
+func (imp *Importer) ListFeatures(ctx context.Context) ([]string, error) {
+	dvcProject := imp.opts.DVCProject
+	if dvcProject == "" {
+		return nil, fmt.Errorf("dvc project is required")
+	}
+	return imp.api.getExistingFeatures(ctx, dvcProject)
+}
+
+// Diff reports, per feature key in the configured source export, whether it
+// already exists in the DevCycle project.
+func (imp *Importer) Diff(ctx context.Context) (map[string]bool, error) {
+	canonicalImport, dvcProject, err := imp.loadAndValidate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := imp.api.getExistingFeatures(ctx, dvcProject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch existing features: %w", err)
+	}
+	existingSet := make(map[string]bool, len(existing))
+	for _, key := range existing {
+		existingSet[key] = true
+	}
+
+	mergedFeatures := mergeRecords(canonicalImport.Features)
+	exists := make(map[string]bool, len(mergedFeatures))
+	for _, feature := range mergedFeatures {
+		exists[GenerateFeatureKey(feature.Name)] = existingSet[GenerateFeatureKey(feature.Name)]
+	}
+	return exists, nil
+}
+
+func (imp *Importer) loadAndValidate(ctx context.Context) (*source.CanonicalImport, string, error) {
+	canonicalImport, err := imp.src.Load(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	dvcProject := imp.opts.DVCProject
+	if dvcProject == "" {
+		dvcProject = canonicalImport.DVCProject
+	}
+	if dvcProject == "" {
+		return nil, "", fmt.Errorf("dvc project is required")
+	}
+
+	return canonicalImport, dvcProject, nil
+}
+
+// mergeRecords filters out features with no variations and merges the rest
+// by name, concatenating variations for duplicate feature names.
+func mergeRecords(records []source.CanonicalFeature) map[string]source.CanonicalFeature {
+	var cleaned []source.CanonicalFeature
+	for _, feature := range records {
+		if len(feature.Variations) == 0 {
+			continue
+		}
+		cleaned = append(cleaned, feature)
+	}
+
+	merged := make(map[string]source.CanonicalFeature)
+	for _, feature := range cleaned {
+		existing, ok := merged[feature.Name]
+		if !ok {
+			merged[feature.Name] = feature
+			continue
+		}
+		existing.Variations = append(existing.Variations, feature.Variations...)
+		merged[feature.Name] = existing
+	}
+	return merged
+}
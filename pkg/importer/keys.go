@@ -0,0 +1,28 @@
+package importer
+
+import (
+	"strings"
+
+	"github.com/ettle/strcase"
+)
+
+// GenerateFeatureKey converts a source feature, variable, or variation name
+// into a DevCycle-compatible key: kebab-case within each dot-delimited
+// section, joined by underscores, with any remaining non-alphanumeric
+// characters stripped.
+func GenerateFeatureKey(name string) string {
+	sections := strings.Split(name, ".")
+	var modifiedSections []string
+	for _, section := range sections {
+		modifiedSections = append(modifiedSections, strcase.ToKebab(section))
+	}
+	key := strings.Join(modifiedSections, "_")
+	// replace all non-alphanumeric characters with empty string; allowing alphanumeric characters, hyphens, periods, and underscores
+	key = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' || r == '.' {
+			return r
+		}
+		return -1 // remove the character
+	}, key)
+	return key
+}
@@ -1,4 +1,4 @@
-package main
+package importer
 
 import (
 	"testing"
@@ -17,7 +17,7 @@ func Test_NamingFormat(t *testing.T) {
 	}
 
 	for _, testCase := range testCases {
-		result := generateFeatureKey(testCase.input)
+		result := GenerateFeatureKey(testCase.input)
 		if result != testCase.expected {
 			t.Errorf("Expected %s, got %s", testCase.expected, result)
 		}
@@ -0,0 +1,17 @@
+package importer
+
+import (
+	"log/slog"
+	"os"
+)
+
+// newLogger builds the slog.Logger used for all import progress output.
+// format selects "json" for machine-readable output or anything else
+// (including the empty string) for human-readable console output.
+func newLogger(format string) *slog.Logger {
+	opts := &slog.HandlerOptions{}
+	if format == "json" {
+		return slog.New(slog.NewJSONHandler(os.Stdout, opts))
+	}
+	return slog.New(slog.NewTextHandler(os.Stdout, opts))
+}
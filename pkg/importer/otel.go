@@ -0,0 +1,56 @@
+package importer
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// instrumentationName identifies this package's spans and metrics to
+// whatever OpenTelemetry SDK the embedding application has configured. If
+// none is configured, these calls are harmless no-ops.
+const instrumentationName = "github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/importer"
+
+var (
+	tracer = otel.Tracer(instrumentationName)
+	meter  = otel.Meter(instrumentationName)
+)
+
+var (
+	featuresProcessed metric.Int64Counter
+	apiLatency        metric.Float64Histogram
+	retryCount        metric.Int64Counter
+)
+
+func init() {
+	var err error
+	featuresProcessed, err = meter.Int64Counter(
+		"taplytics_import.features_processed",
+		metric.WithDescription("Number of features processed by the importer, labeled by outcome"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	apiLatency, err = meter.Float64Histogram(
+		"taplytics_import.api_request_duration",
+		metric.WithDescription("Latency of DevCycle API requests"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	retryCount, err = meter.Int64Counter(
+		"taplytics_import.api_retries",
+		metric.WithDescription("Number of DevCycle API request retries"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// spanName is a small helper so every importer span is prefixed
+// consistently regardless of which method starts it.
+func spanName(name string) string {
+	return "importer." + name
+}
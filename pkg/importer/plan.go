@@ -0,0 +1,227 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DevCycleHQ-Labs/taplytics-import-tool/pkg/source"
+)
+
+// Action describes what an import run would do to a given resource.
+type Action string
+
+const (
+	ActionCreate   Action = "create"
+	ActionSkip     Action = "skip"
+	ActionConflict Action = "conflict"
+)
+
+// CustomPropertyPlan describes the planned action for a single DevCycle
+// custom property.
+type CustomPropertyPlan struct {
+	Key      string `json:"key"`
+	DataType string `json:"dataType"`
+	Action   Action `json:"action"`
+}
+
+// VariablePlan describes the planned action for a single DevCycle variable.
+type VariablePlan struct {
+	Key    string `json:"key"`
+	Type   string `json:"type"`
+	Action Action `json:"action"`
+}
+
+// VariationPlan describes the planned action for a single DevCycle
+// variation.
+type VariationPlan struct {
+	Key    string `json:"key"`
+	Action Action `json:"action"`
+}
+
+// TargetingRulePlan describes the planned action for a feature's targeting
+// rules in a single environment.
+type TargetingRulePlan struct {
+	Environment string `json:"environment"`
+	Action      Action `json:"action"`
+}
+
+// FeaturePlan describes the planned action for a single DevCycle feature and
+// its nested resources.
+type FeaturePlan struct {
+	Key    string `json:"key"`
+	Name   string `json:"name"`
+	Action Action `json:"action"`
+	// Prune is set when Action is ActionConflict and the reconciliation
+	// will remove variables/variations absent from the source, rather
+	// than merging additively.
+	Prune          bool                `json:"prune,omitempty"`
+	Variables      []VariablePlan      `json:"variables,omitempty"`
+	Variations     []VariationPlan     `json:"variations,omitempty"`
+	TargetingRules []TargetingRulePlan `json:"targetingRules,omitempty"`
+}
+
+// Plan is the full set of planned changes for an import run. It is produced
+// by a dry run and can be persisted to disk for later use by the apply
+// subcommand.
+type Plan struct {
+	FilePath         string               `json:"filePath"`
+	SourceKind       string               `json:"sourceKind"`
+	DVCProject       string               `json:"dvcProject"`
+	CustomProperties []CustomPropertyPlan `json:"customProperties"`
+	Features         []FeaturePlan        `json:"features"`
+}
+
+// WriteFile persists the plan as indented JSON.
+func (p *Plan) WriteFile(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal plan: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write plan file %s: %w", path, err)
+	}
+	return nil
+}
+
+// ReadPlanFile loads a plan previously written by Plan.WriteFile.
+func ReadPlanFile(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plan file %s: %w", path, err)
+	}
+	var plan Plan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("failed to parse plan file %s: %w", path, err)
+	}
+	return &plan, nil
+}
+
+// String renders the plan as a human-readable report.
+func (p *Plan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Plan for DevCycle project %q\n", p.DVCProject)
+
+	fmt.Fprintln(&b, "\nCustom properties:")
+	for _, cp := range p.CustomProperties {
+		fmt.Fprintf(&b, "  %s %s (%s)\n", actionSymbol(cp.Action), cp.Key, cp.DataType)
+	}
+
+	fmt.Fprintln(&b, "\nFeatures:")
+	for _, f := range p.Features {
+		fmt.Fprintf(&b, "  %s %s\n", actionSymbol(f.Action), f.Key)
+		if f.Action == ActionConflict {
+			fmt.Fprintf(&b, "      (already exists, will be reconciled; prune=%t)\n", f.Prune)
+		}
+		if f.Action == ActionSkip {
+			continue
+		}
+		for _, v := range f.Variables {
+			fmt.Fprintf(&b, "      %s variable %s (%s)\n", actionSymbol(v.Action), v.Key, v.Type)
+		}
+		for _, v := range f.Variations {
+			fmt.Fprintf(&b, "      %s variation %s\n", actionSymbol(v.Action), v.Key)
+		}
+		for _, t := range f.TargetingRules {
+			fmt.Fprintf(&b, "      %s targeting rule (%s)\n", actionSymbol(t.Action), t.Environment)
+		}
+	}
+
+	return b.String()
+}
+
+func actionSymbol(a Action) string {
+	switch a {
+	case ActionCreate:
+		return "+"
+	case ActionConflict:
+		return "!"
+	default:
+		return "="
+	}
+}
+
+// buildPlan computes the plan for mergedFeatures given the custom
+// properties and features that already exist in the DevCycle project. It
+// makes no API calls itself. updateExisting and prune mirror
+// Options.UpdateExisting/Options.Prune, so the plan reports what createDevCycleFeature
+// will actually do on a 409: reconcile via fetch-diff-patch when set, or
+// skip when not.
+func buildPlan(dvcProject string, existingFeatureKeys, existingCustomProps []string, customData map[string]string, mergedFeatures map[string]source.CanonicalFeature, updateExisting, prune bool) *Plan {
+	existingFeatures := make(map[string]bool, len(existingFeatureKeys))
+	for _, key := range existingFeatureKeys {
+		existingFeatures[key] = true
+	}
+	existingProps := make(map[string]bool, len(existingCustomProps))
+	for _, key := range existingCustomProps {
+		existingProps[key] = true
+	}
+
+	plan := &Plan{DVCProject: dvcProject}
+
+	for key, dataType := range customData {
+		action := ActionCreate
+		if existingProps[key] {
+			action = ActionSkip
+		}
+		plan.CustomProperties = append(plan.CustomProperties, CustomPropertyPlan{
+			Key:      key,
+			DataType: dataType,
+			Action:   action,
+		})
+	}
+
+	for _, feature := range mergedFeatures {
+		featureKey := GenerateFeatureKey(feature.Name)
+		featurePlan := FeaturePlan{Key: featureKey, Name: feature.Name}
+
+		exists := existingFeatures[featureKey]
+		if exists && !updateExisting {
+			featurePlan.Action = ActionSkip
+			plan.Features = append(plan.Features, featurePlan)
+			continue
+		}
+
+		action := ActionCreate
+		if exists {
+			// With UpdateExisting set, createDevCycleFeature reconciles
+			// the feature via fetch-diff-patch instead of skipping it.
+			action = ActionConflict
+			featurePlan.Prune = prune
+		}
+		featurePlan.Action = action
+
+		dedupeVariables := make(map[string]bool)
+		for _, variation := range feature.Variations {
+			featurePlan.Variations = append(featurePlan.Variations, VariationPlan{
+				Key:    GenerateFeatureKey(variation.Name),
+				Action: action,
+			})
+			for _, variable := range variation.Variables {
+				if dedupeVariables[variable.Name] {
+					continue
+				}
+				dedupeVariables[variable.Name] = true
+				featurePlan.Variables = append(featurePlan.Variables, VariablePlan{
+					Key:    GenerateFeatureKey(variable.Name),
+					Type:   variable.Type,
+					Action: action,
+				})
+			}
+		}
+
+		if len(feature.Targets) > 0 {
+			for _, env := range []string{"development", "staging", "production"} {
+				featurePlan.TargetingRules = append(featurePlan.TargetingRules, TargetingRulePlan{
+					Environment: env,
+					Action:      action,
+				})
+			}
+		}
+
+		plan.Features = append(plan.Features, featurePlan)
+	}
+
+	return plan
+}
@@ -0,0 +1,38 @@
+package importer
+
+import (
+	"os"
+
+	"github.com/schollz/progressbar/v3"
+	"golang.org/x/term"
+)
+
+// progressReporter tracks feature-import progress. It is driven by the
+// same success/failure events that feed featuresProcessed, so the bar and
+// the metrics never disagree.
+type progressReporter interface {
+	Add(n int)
+	Close()
+}
+
+type noopProgress struct{}
+
+func (noopProgress) Add(int) {}
+func (noopProgress) Close()  {}
+
+type barProgress struct {
+	bar *progressbar.ProgressBar
+}
+
+func (b *barProgress) Add(n int) { _ = b.bar.Add(n) }
+func (b *barProgress) Close()    { _ = b.bar.Close() }
+
+// newProgressReporter returns a TTY progress bar when enabled and stdout is
+// a terminal, or a no-op otherwise so piping output to a file or CI log
+// doesn't fill it with bar redraws.
+func newProgressReporter(enabled bool, total int) progressReporter {
+	if !enabled || total == 0 || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return noopProgress{}
+	}
+	return &barProgress{bar: progressbar.Default(int64(total), "importing features")}
+}
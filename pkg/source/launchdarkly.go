@@ -0,0 +1,185 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// LaunchDarklySource loads a LaunchDarkly flag export (the JSON returned by
+// `ldapi export` / the "Export flags" UI action for an environment) from
+// FilePath.
+type LaunchDarklySource struct {
+	FilePath string
+}
+
+type ldExport struct {
+	Flags []ldFlag `json:"flags"`
+}
+
+type ldFlag struct {
+	Key         string         `json:"key"`
+	Name        string         `json:"name"`
+	Tags        []string       `json:"tags"`
+	Variations  []ldVariation  `json:"variations"`
+	Fallthrough ldVariationRef `json:"fallthrough"`
+	Rules       []ldRule       `json:"rules"`
+}
+
+type ldVariation struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+type ldVariationRef struct {
+	Variation int `json:"variation"`
+}
+
+type ldRule struct {
+	Clauses   []ldClause `json:"clauses"`
+	Variation int        `json:"variation"`
+}
+
+type ldClause struct {
+	Attribute string `json:"attribute"`
+	Op        string `json:"op"`
+	Values    []any  `json:"values"`
+	Negate    bool   `json:"negate"`
+}
+
+// Load reads and parses the LaunchDarkly flag export at FilePath.
+func (s *LaunchDarklySource) Load(ctx context.Context) (*CanonicalImport, error) {
+	fileContent, err := os.ReadFile(s.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	var export ldExport
+	if err := json.Unmarshal(fileContent, &export); err != nil {
+		return nil, fmt.Errorf("error parsing file: %w", err)
+	}
+	if len(export.Flags) == 0 {
+		return nil, fmt.Errorf("no flags found in LaunchDarkly export")
+	}
+
+	features := make([]CanonicalFeature, 0, len(export.Flags))
+	for _, flag := range export.Flags {
+		features = append(features, flag.toCanonical())
+	}
+
+	return &CanonicalImport{Features: features}, nil
+}
+
+func (f ldFlag) toCanonical() CanonicalFeature {
+	feature := CanonicalFeature{Name: f.Name, Tags: f.Tags}
+	if feature.Name == "" {
+		feature.Name = f.Key
+	}
+
+	variationName := func(i int) string {
+		if i < 0 || i >= len(f.Variations) {
+			return fmt.Sprintf("variation-%d", i)
+		}
+		if f.Variations[i].Name != "" {
+			return f.Variations[i].Name
+		}
+		return fmt.Sprintf("variation-%d", i)
+	}
+
+	for i, variation := range f.Variations {
+		feature.Variations = append(feature.Variations, CanonicalVariation{
+			Name: variationName(i),
+			Variables: []CanonicalVariable{{
+				Name:  f.Key,
+				Type:  ldValueType(variation.Value),
+				Value: variation.Value,
+			}},
+		})
+	}
+
+	feature.Distribution = append(feature.Distribution, CanonicalDistribution{
+		VariationName: variationName(f.Fallthrough.Variation),
+		Percentage:    1,
+	})
+
+	for _, rule := range f.Rules {
+		feature.Targets = append(feature.Targets, CanonicalTarget{
+			Audience: CanonicalAudience{
+				Filters: ldClausesToCanonical(rule.Clauses),
+			},
+			Distribution: []CanonicalDistribution{{
+				VariationName: variationName(rule.Variation),
+				Percentage:    1,
+			}},
+		})
+	}
+
+	return feature
+}
+
+// ldComparatorTranslation maps LaunchDarkly's clause "op" values onto the
+// canonical comparator vocabulary (see CanonicalFilter). Ops with no close
+// canonical equivalent (e.g. "segmentMatch") pass through unchanged as a
+// best effort.
+var ldComparatorTranslation = map[string]string{
+	"in":                 "eq",
+	"contains":           "contain",
+	"startsWith":         "contain",
+	"endsWith":           "contain",
+	"lessThan":           "lt",
+	"lessThanOrEqual":    "lte",
+	"greaterThan":        "gt",
+	"greaterThanOrEqual": "gte",
+	"before":             "before",
+	"after":              "after",
+	"semVerEqual":        "eq",
+	"semVerLessThan":     "lt",
+	"semVerGreaterThan":  "gt",
+}
+
+// ldSubTypeTranslation maps LaunchDarkly's built-in context attribute names
+// onto the canonical subType vocabulary (see pkg/importer's
+// filterSubTypeTranslation). Custom context attributes, and any built-in
+// attribute with no close canonical equivalent, pass through unchanged as a
+// best effort.
+var ldSubTypeTranslation = map[string]string{
+	"key": "user_id",
+}
+
+func ldClausesToCanonical(clauses []ldClause) CanonicalFilterGroup {
+	group := CanonicalFilterGroup{Operator: "and"}
+	for _, clause := range clauses {
+		comparator, ok := ldComparatorTranslation[clause.Op]
+		if !ok {
+			comparator = clause.Op
+		}
+		if clause.Negate {
+			comparator = negateComparator(comparator)
+		}
+		subType := clause.Attribute
+		if renamed, ok := ldSubTypeTranslation[subType]; ok {
+			subType = renamed
+		}
+		group.Filters = append(group.Filters, CanonicalFilter{
+			Type:       "user",
+			SubType:    subType,
+			Comparator: comparator,
+			Values:     clause.Values,
+		})
+	}
+	return group
+}
+
+func ldValueType(value any) string {
+	switch value.(type) {
+	case bool:
+		return "Boolean"
+	case float64:
+		return "Number"
+	case map[string]any, []any:
+		return "JSON"
+	default:
+		return "String"
+	}
+}
@@ -0,0 +1,259 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// OptimizelySource loads features and their experiments from the Optimizely
+// Feature Experimentation REST API. Credentials are read from the
+// OPTIMIZELY_API_TOKEN and OPTIMIZELY_PROJECT_ID environment variables,
+// matching how pkg/importer resolves DevCycle credentials.
+type OptimizelySource struct {
+	apiToken  string
+	projectID string
+	client    *http.Client
+	baseURL   string
+}
+
+// NewOptimizelySource builds an OptimizelySource, resolving credentials from
+// the environment.
+func NewOptimizelySource() (*OptimizelySource, error) {
+	apiToken := os.Getenv("OPTIMIZELY_API_TOKEN")
+	projectID := os.Getenv("OPTIMIZELY_PROJECT_ID")
+	if apiToken == "" || projectID == "" {
+		return nil, fmt.Errorf("optimizely source requires OPTIMIZELY_API_TOKEN and OPTIMIZELY_PROJECT_ID")
+	}
+	return &OptimizelySource{
+		apiToken:  apiToken,
+		projectID: projectID,
+		client:    &http.Client{Timeout: 10 * time.Second},
+		baseURL:   "https://api.optimizely.com/v2",
+	}, nil
+}
+
+type optimizelyFeature struct {
+	Key       string               `json:"key"`
+	Name      string               `json:"name"`
+	Tags      []string             `json:"tags"`
+	Variables []optimizelyVariable `json:"variables"`
+}
+
+type optimizelyVariable struct {
+	ID           string `json:"id"`
+	Key          string `json:"key"`
+	Type         string `json:"type"`
+	DefaultValue string `json:"default_value"`
+}
+
+type optimizelyExperiment struct {
+	Key          string                        `json:"key"`
+	FeatureID    int                           `json:"feature_id"`
+	Variations   []optimizelyVariation         `json:"variations"`
+	Audiences    []optimizelyAudienceRef       `json:"audience_conditions"`
+	TrafficAlloc []optimizelyTrafficAllocation `json:"traffic_allocation"`
+}
+
+type optimizelyVariation struct {
+	ID        string                  `json:"id"`
+	Key       string                  `json:"key"`
+	Variables []optimizelyVarOverride `json:"variables"`
+}
+
+type optimizelyVarOverride struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+type optimizelyAudienceRef struct {
+	Name       string `json:"name"`
+	Attribute  string `json:"attribute"`
+	Comparator string `json:"match_type"`
+	Value      any    `json:"value"`
+}
+
+type optimizelyTrafficAllocation struct {
+	EntityID   string `json:"entity_id"`
+	EndOfRange int    `json:"end_of_range"` // 0-10000
+}
+
+// Load fetches every feature in the configured project and the experiments
+// attached to it, translating both into canonical features.
+func (s *OptimizelySource) Load(ctx context.Context) (*CanonicalImport, error) {
+	features, err := s.fetchFeatures(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical := make([]CanonicalFeature, 0, len(features))
+	for _, feature := range features {
+		experiments, err := s.fetchExperiments(ctx, feature.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch experiments for feature %s: %w", feature.Key, err)
+		}
+		canonical = append(canonical, feature.toCanonical(experiments))
+	}
+
+	return &CanonicalImport{Features: canonical}, nil
+}
+
+func (s *OptimizelySource) fetchFeatures(ctx context.Context) ([]optimizelyFeature, error) {
+	url := fmt.Sprintf("%s/projects/%s/features", s.baseURL, s.projectID)
+	var features []optimizelyFeature
+	if err := s.get(ctx, url, &features); err != nil {
+		return nil, fmt.Errorf("failed to fetch features: %w", err)
+	}
+	return features, nil
+}
+
+func (s *OptimizelySource) fetchExperiments(ctx context.Context, featureKey string) ([]optimizelyExperiment, error) {
+	url := fmt.Sprintf("%s/experiments?feature_key=%s&project_id=%s", s.baseURL, featureKey, s.projectID)
+	var experiments []optimizelyExperiment
+	if err := s.get(ctx, url, &experiments); err != nil {
+		return nil, err
+	}
+	return experiments, nil
+}
+
+func (s *OptimizelySource) get(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiToken)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("Optimizely API returned status %d: %s", resp.StatusCode, string(body))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (f optimizelyFeature) toCanonical(experiments []optimizelyExperiment) CanonicalFeature {
+	feature := CanonicalFeature{Name: f.Name, Tags: f.Tags}
+	if feature.Name == "" {
+		feature.Name = f.Key
+	}
+
+	defaults := make(map[string]any, len(f.Variables))
+	varType := make(map[string]string, len(f.Variables))
+	varKeyByID := make(map[string]string, len(f.Variables))
+	for _, variable := range f.Variables {
+		defaults[variable.Key] = variable.DefaultValue
+		varType[variable.Key] = optimizelyVarType(variable.Type)
+		varKeyByID[variable.ID] = variable.Key
+	}
+
+	for _, experiment := range experiments {
+		variationKeyByID := make(map[string]string, len(experiment.Variations))
+		for _, variation := range experiment.Variations {
+			variationKeyByID[variation.ID] = variation.Key
+		}
+
+		for _, variation := range experiment.Variations {
+			values := cloneAnyMap(defaults)
+			for _, override := range variation.Variables {
+				key, ok := varKeyByID[override.ID]
+				if !ok {
+					continue
+				}
+				values[key] = override.Value
+			}
+
+			cv := CanonicalVariation{Name: variation.Key}
+			for key, value := range values {
+				cv.Variables = append(cv.Variables, CanonicalVariable{
+					Name:  key,
+					Type:  varType[key],
+					Value: value,
+				})
+			}
+			feature.Variations = append(feature.Variations, cv)
+		}
+
+		target := CanonicalTarget{
+			Audience: CanonicalAudience{Filters: optimizelyAudiencesToCanonical(experiment.Audiences)},
+		}
+		for _, alloc := range experiment.TrafficAlloc {
+			variationName, ok := variationKeyByID[alloc.EntityID]
+			if !ok {
+				variationName = alloc.EntityID
+			}
+			target.Distribution = append(target.Distribution, CanonicalDistribution{
+				VariationName: variationName,
+				Percentage:    float64(alloc.EndOfRange) / 10000,
+			})
+		}
+		feature.Targets = append(feature.Targets, target)
+	}
+
+	return feature
+}
+
+// optimizelyComparatorTranslation maps Optimizely's audience condition
+// match_type values onto the canonical comparator vocabulary (see
+// CanonicalFilter). match_types with no close canonical equivalent pass
+// through unchanged as a best effort.
+var optimizelyComparatorTranslation = map[string]string{
+	"exact":     "eq",
+	"substring": "contain",
+	"gt":        "gt",
+	"ge":        "gte",
+	"lt":        "lt",
+	"le":        "lte",
+	"exists":    "exist",
+	"semver_eq": "eq",
+	"semver_gt": "gt",
+	"semver_ge": "gte",
+	"semver_lt": "lt",
+	"semver_le": "lte",
+}
+
+func optimizelyAudiencesToCanonical(audiences []optimizelyAudienceRef) CanonicalFilterGroup {
+	group := CanonicalFilterGroup{Operator: "and"}
+	for _, audience := range audiences {
+		comparator, ok := optimizelyComparatorTranslation[audience.Comparator]
+		if !ok {
+			comparator = audience.Comparator
+		}
+		group.Filters = append(group.Filters, CanonicalFilter{
+			Type:       "user",
+			SubType:    audience.Attribute,
+			Comparator: comparator,
+			Values:     []any{audience.Value},
+		})
+	}
+	return group
+}
+
+func optimizelyVarType(t string) string {
+	switch t {
+	case "boolean":
+		return "Boolean"
+	case "integer", "double":
+		return "Number"
+	case "json":
+		return "JSON"
+	default:
+		return "String"
+	}
+}
+
+func cloneAnyMap(m map[string]any) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
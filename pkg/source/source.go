@@ -0,0 +1,169 @@
+// Package source defines the canonical feature-flag representation that
+// pkg/importer consumes, plus one Source implementation per supported
+// vendor. Adding a new vendor means adding a new file here that knows how
+// to translate that vendor's export/API into the canonical types below; it
+// does not require any changes to pkg/importer.
+package source
+
+import (
+	"context"
+	"fmt"
+)
+
+// CanonicalVariable is a single key/value pair attached to a variation.
+type CanonicalVariable struct {
+	Name  string
+	Type  string // DevCycle variable type: "String", "Number", "Boolean", or "JSON".
+	Value any
+}
+
+// CanonicalVariation is one variant of a feature, with the variable values
+// it sets.
+type CanonicalVariation struct {
+	Name         string
+	Variables    []CanonicalVariable
+	Distribution float64
+}
+
+// CanonicalDistribution assigns a rollout percentage to a variation.
+type CanonicalDistribution struct {
+	VariationName string
+	Percentage    float64
+}
+
+// CanonicalFilter is a single targeting condition. Comparator is one of the
+// canonical comparator tokens below, not the source vendor's native
+// operator string; each Source normalizes its own vocabulary into these
+// before returning a CanonicalFilter, the way pkg/importer expects:
+//
+//	eq, neq           equals / not equals
+//	contain, not_contain
+//	exist, not_exist
+//	gt, gte, lt, lte  numeric/semver ordering
+//	before, after     date ordering
+type CanonicalFilter struct {
+	Type        string
+	SubType     string
+	Comparator  string
+	Values      []any
+	DataKey     string
+	DataKeyType string
+}
+
+// canonicalNegations pairs each canonical comparator token with its
+// negation, for sources whose native format expresses negation as a
+// separate flag (e.g. LaunchDarkly's "negate", Split's "negate") rather
+// than its own operator.
+var canonicalNegations = map[string]string{
+	"eq":          "neq",
+	"neq":         "eq",
+	"contain":     "not_contain",
+	"not_contain": "contain",
+	"exist":       "not_exist",
+	"not_exist":   "exist",
+	"gt":          "lte",
+	"lte":         "gt",
+	"gte":         "lt",
+	"lt":          "gte",
+	"before":      "after",
+	"after":       "before",
+}
+
+// negateComparator returns the canonical comparator that negates comparator.
+// A comparator outside the canonical vocabulary (already a best-effort
+// passthrough from an unrecognized vendor operator) is returned prefixed
+// with "not_", since there's no canonical negation to look up.
+func negateComparator(comparator string) string {
+	if negated, ok := canonicalNegations[comparator]; ok {
+		return negated
+	}
+	return "not_" + comparator
+}
+
+// CanonicalFilterGroup is a set of filters combined with a boolean operator,
+// mirroring DevCycle's audience filter shape.
+type CanonicalFilterGroup struct {
+	Operator string
+	Filters  []CanonicalFilter
+}
+
+// CanonicalAudience is a named group of targeting filters.
+type CanonicalAudience struct {
+	Name    string
+	Filters CanonicalFilterGroup
+}
+
+// CanonicalTarget pairs an audience with the distribution that applies when
+// a user matches it.
+type CanonicalTarget struct {
+	Name         string
+	Audience     CanonicalAudience
+	Distribution []CanonicalDistribution
+}
+
+// CanonicalFeature is the vendor-agnostic shape that
+// pkg/importer.devcycleAPI creates features and targeting rules from.
+type CanonicalFeature struct {
+	Name         string
+	Tags         []string
+	Variations   []CanonicalVariation
+	Distribution []CanonicalDistribution
+
+	// Audience is populated only by sources that expose a single,
+	// top-level audience for the feature, independent of Targets.
+	Audience CanonicalAudience
+
+	// Targets holds the per-rule audiences a source defines (DevCycle's
+	// targeting model, LaunchDarkly's rules, Split's conditions, etc.).
+	Targets []CanonicalTarget
+}
+
+// CanonicalImport is everything parsed from one export/API call: the
+// features to import, and the DevCycle project they belong to (if the
+// source knows it).
+type CanonicalImport struct {
+	DVCProject string
+	Features   []CanonicalFeature
+}
+
+// CustomDataProperties collects the custom-data audience filters referenced
+// anywhere in the import, keyed by data key, so the caller can ensure those
+// DevCycle custom properties exist before creating targeting rules.
+func (ci *CanonicalImport) CustomDataProperties() map[string]string {
+	customData := make(map[string]string)
+	for _, feature := range ci.Features {
+		for _, target := range feature.Targets {
+			for _, filter := range target.Audience.Filters.Filters {
+				if filter.SubType == "customData" && filter.DataKey != "" {
+					if _, ok := customData[filter.DataKey]; !ok {
+						customData[filter.DataKey] = filter.DataKeyType
+					}
+				}
+			}
+		}
+	}
+	return customData
+}
+
+// Source loads feature flag data from a vendor export file or API and
+// translates it into the canonical representation above.
+type Source interface {
+	Load(ctx context.Context) (*CanonicalImport, error)
+}
+
+// New constructs the Source for the given vendor name. filePath is ignored
+// by sources that load entirely from a remote API.
+func New(kind, filePath string) (Source, error) {
+	switch kind {
+	case "", "taplytics":
+		return &TaplyticsSource{FilePath: filePath}, nil
+	case "launchdarkly":
+		return &LaunchDarklySource{FilePath: filePath}, nil
+	case "split":
+		return &SplitSource{FilePath: filePath}, nil
+	case "optimizely":
+		return NewOptimizelySource()
+	default:
+		return nil, fmt.Errorf("unknown source %q: must be one of taplytics, launchdarkly, split, optimizely", kind)
+	}
+}
@@ -0,0 +1,180 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// SplitSource loads a Split.io split definitions export (the JSON returned
+// by the "Export environment" action, i.e. a top-level "splits" array) from
+// FilePath.
+type SplitSource struct {
+	FilePath string
+}
+
+type splitExport struct {
+	Splits []splitDefinition `json:"splits"`
+}
+
+type splitDefinition struct {
+	Name             string           `json:"name"`
+	Tags             []string         `json:"tags"`
+	Treatments       []splitTreatment `json:"treatments"`
+	DefaultTreatment string           `json:"defaultTreatment"`
+	Conditions       []splitCondition `json:"conditions"`
+}
+
+type splitTreatment struct {
+	Name          string `json:"name"`
+	Configuration string `json:"configurations"`
+}
+
+type splitCondition struct {
+	MatcherGroup splitMatcherGroup `json:"matcherGroup"`
+	Partitions   []splitPartition  `json:"partitions"`
+}
+
+type splitMatcherGroup struct {
+	Combiner string         `json:"combiner"`
+	Matchers []splitMatcher `json:"matchers"`
+}
+
+type splitMatcher struct {
+	MatcherType       string   `json:"matcherType"`
+	KeySelector       string   `json:"keySelector"`
+	Negate            bool     `json:"negate"`
+	WhitelistValues   []string `json:"whitelistValues"`
+	UnaryNumericValue float64  `json:"unaryNumericValue"`
+}
+
+type splitPartition struct {
+	Treatment string `json:"treatment"`
+	Size      int    `json:"size"` // 0-100
+}
+
+// Load reads and parses the Split split definitions export at FilePath.
+func (s *SplitSource) Load(ctx context.Context) (*CanonicalImport, error) {
+	fileContent, err := os.ReadFile(s.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	var export splitExport
+	if err := json.Unmarshal(fileContent, &export); err != nil {
+		return nil, fmt.Errorf("error parsing file: %w", err)
+	}
+	if len(export.Splits) == 0 {
+		return nil, fmt.Errorf("no splits found in Split export")
+	}
+
+	features := make([]CanonicalFeature, 0, len(export.Splits))
+	for _, split := range export.Splits {
+		features = append(features, split.toCanonical())
+	}
+
+	return &CanonicalImport{Features: features}, nil
+}
+
+func (sp splitDefinition) toCanonical() CanonicalFeature {
+	feature := CanonicalFeature{Name: sp.Name, Tags: sp.Tags}
+
+	for _, treatment := range sp.Treatments {
+		feature.Variations = append(feature.Variations, CanonicalVariation{
+			Name: treatment.Name,
+			Variables: []CanonicalVariable{{
+				Name:  sp.Name,
+				Type:  "String",
+				Value: treatment.Configuration,
+			}},
+		})
+	}
+
+	if sp.DefaultTreatment != "" {
+		feature.Distribution = append(feature.Distribution, CanonicalDistribution{
+			VariationName: sp.DefaultTreatment,
+			Percentage:    1,
+		})
+	}
+
+	for _, condition := range sp.Conditions {
+		target := CanonicalTarget{
+			Audience: CanonicalAudience{
+				Filters: splitMatchersToCanonical(condition.MatcherGroup),
+			},
+		}
+		for _, partition := range condition.Partitions {
+			if partition.Size == 0 {
+				continue
+			}
+			target.Distribution = append(target.Distribution, CanonicalDistribution{
+				VariationName: partition.Treatment,
+				Percentage:    float64(partition.Size) / 100,
+			})
+		}
+		feature.Targets = append(feature.Targets, target)
+	}
+
+	return feature
+}
+
+// splitComparatorTranslation maps Split's matcherType values onto the
+// canonical comparator vocabulary (see CanonicalFilter). Matcher types with
+// no close canonical equivalent (e.g. "IN_SEGMENT") pass through unchanged
+// as a best effort.
+var splitComparatorTranslation = map[string]string{
+	"WHITELIST":                "eq",
+	"EQUAL_TO":                 "eq",
+	"EQUAL_TO_BOOLEAN":         "eq",
+	"GREATER_THAN_OR_EQUAL_TO": "gte",
+	"LESS_THAN_OR_EQUAL_TO":    "lte",
+	"CONTAINS_STRING":          "contain",
+	"STARTS_WITH":              "contain",
+	"ENDS_WITH":                "contain",
+}
+
+// splitSubTypeTranslation maps Split's matcher key selectors onto the
+// canonical subType vocabulary (see pkg/importer's
+// filterSubTypeTranslation). A matcher with no keySelector matches on
+// Split's bucketing key, which is the equivalent of DevCycle's user id.
+// Custom trait key selectors, and any with no close canonical equivalent,
+// pass through unchanged as a best effort.
+var splitSubTypeTranslation = map[string]string{
+	"": "user_id",
+}
+
+func splitMatchersToCanonical(group splitMatcherGroup) CanonicalFilterGroup {
+	operator := "and"
+	if group.Combiner == "OR" {
+		operator = "or"
+	}
+	canonical := CanonicalFilterGroup{Operator: operator}
+	for _, matcher := range group.Matchers {
+		comparator, ok := splitComparatorTranslation[matcher.MatcherType]
+		if !ok {
+			comparator = matcher.MatcherType
+		}
+		if matcher.Negate {
+			comparator = negateComparator(comparator)
+		}
+		subType := matcher.KeySelector
+		if renamed, ok := splitSubTypeTranslation[subType]; ok {
+			subType = renamed
+		}
+		var values []any
+		for _, v := range matcher.WhitelistValues {
+			values = append(values, v)
+		}
+		if matcher.UnaryNumericValue != 0 {
+			values = append(values, matcher.UnaryNumericValue)
+		}
+		canonical.Filters = append(canonical.Filters, CanonicalFilter{
+			Type:       "user",
+			SubType:    subType,
+			Comparator: comparator,
+			Values:     values,
+		})
+	}
+	return canonical
+}
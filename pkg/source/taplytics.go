@@ -0,0 +1,170 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// TaplyticsSource loads a Taplytics JSON export from FilePath.
+type TaplyticsSource struct {
+	FilePath string
+}
+
+type tlVariable struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+type tlImportFormat struct {
+	TLProject  string           `json:"tl_project"`
+	DVCProject string           `json:"dvc_project"`
+	Records    []tlImportRecord `json:"records"`
+}
+
+type tlImportRecord struct {
+	ID           string           `json:"_id"`
+	FeatureName  string           `json:"featureName"`
+	Variations   []tlVariation    `json:"variations"`
+	Tags         []string         `json:"tags"`
+	Targets      []tlTarget       `json:"targets"`
+	Distribution []tlDistribution `json:"distribution"`
+}
+
+type tlDistribution struct {
+	Name       string  `json:"name"`
+	Percentage float64 `json:"percentage"`
+}
+
+type tlVariation struct {
+	Name         string       `json:"name"`
+	Variables    []tlVariable `json:"variables"`
+	Distribution float64      `json:"distribution"`
+}
+
+type tlTarget struct {
+	Name         string           `json:"name"`
+	Audience     tlAudience       `json:"audience"`
+	Distribution []tlDistribution `json:"distribution"`
+}
+
+type tlAudience struct {
+	Name    string   `json:"name"`
+	Filters tlFilter `json:"filters"`
+}
+
+type tlFilter struct {
+	Operator string         `json:"operator"`
+	Filters  []tlFilterItem `json:"filters"`
+}
+
+type tlFilterItem struct {
+	Type        string `json:"type,omitempty"`
+	Comparator  string `json:"comparator,omitempty"`
+	Values      []any  `json:"values,omitempty"`
+	SubType     string `json:"subType,omitempty"`
+	DataKey     string `json:"dataKey,omitempty"`
+	DataKeyType string `json:"dataKeyType,omitempty"`
+}
+
+// Load reads and parses the Taplytics export at FilePath.
+func (s *TaplyticsSource) Load(ctx context.Context) (*CanonicalImport, error) {
+	fileContent, err := os.ReadFile(s.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	var tlImport tlImportFormat
+	if err := json.Unmarshal(fileContent, &tlImport); err != nil {
+		return nil, fmt.Errorf("error parsing file: %w", err)
+	}
+	if tlImport.TLProject == "" {
+		return nil, fmt.Errorf("tl_project is required")
+	}
+
+	features := make([]CanonicalFeature, 0, len(tlImport.Records))
+	for _, record := range tlImport.Records {
+		features = append(features, record.toCanonical())
+	}
+
+	return &CanonicalImport{DVCProject: tlImport.DVCProject, Features: features}, nil
+}
+
+func (r tlImportRecord) toCanonical() CanonicalFeature {
+	feature := CanonicalFeature{
+		Name: r.FeatureName,
+		Tags: r.Tags,
+	}
+
+	for _, variation := range r.Variations {
+		cv := CanonicalVariation{Name: variation.Name, Distribution: variation.Distribution}
+		for _, variable := range variation.Variables {
+			cv.Variables = append(cv.Variables, CanonicalVariable{
+				Name:  variable.Name,
+				Type:  convertTaplyticsVarType(variable.Type),
+				Value: variable.Value,
+			})
+		}
+		feature.Variations = append(feature.Variations, cv)
+	}
+
+	for _, dist := range r.Distribution {
+		feature.Distribution = append(feature.Distribution, CanonicalDistribution{
+			VariationName: dist.Name,
+			Percentage:    dist.Percentage,
+		})
+	}
+
+	for _, target := range r.Targets {
+		feature.Targets = append(feature.Targets, target.toCanonical())
+	}
+
+	return feature
+}
+
+func (t tlTarget) toCanonical() CanonicalTarget {
+	target := CanonicalTarget{
+		Name:     t.Name,
+		Audience: t.Audience.toCanonical(),
+	}
+	for _, dist := range t.Distribution {
+		target.Distribution = append(target.Distribution, CanonicalDistribution{
+			VariationName: dist.Name,
+			Percentage:    dist.Percentage,
+		})
+	}
+	return target
+}
+
+func (a tlAudience) toCanonical() CanonicalAudience {
+	group := CanonicalFilterGroup{Operator: a.Filters.Operator}
+	for _, filter := range a.Filters.Filters {
+		group.Filters = append(group.Filters, CanonicalFilter{
+			Type:        "user",
+			SubType:     filter.SubType,
+			Comparator:  filter.Comparator,
+			Values:      filter.Values,
+			DataKey:     filter.DataKey,
+			DataKeyType: filter.DataKeyType,
+		})
+	}
+	return CanonicalAudience{Name: a.Name, Filters: group}
+}
+
+func convertTaplyticsVarType(tlType string) string {
+	switch strings.ToLower(tlType) {
+	case "string":
+		return "String"
+	case "number":
+		return "Number"
+	case "boolean":
+		return "Boolean"
+	case "json":
+		return "JSON"
+	default:
+		return "String" // Default to String
+	}
+}